@@ -0,0 +1,304 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/faiface/pixel"
+
+	"github.com/SourceAura/Axols/core"
+)
+
+// FitnessFunc scores an axol for selection purposes. The default scores on
+// consumedFood, but callers can swap in survival time, distance travelled,
+// or anything else that fits through this signature.
+type FitnessFunc func(a *AxolActor) float64
+
+// consumedFoodFitness is the original, and still default, fitness measure.
+func consumedFoodFitness(a *AxolActor) float64 {
+	return float64(a.consumedFood)
+}
+
+// Selector picks one parent out of the current population according to
+// some selection pressure.
+type Selector interface {
+	Select(population []*AxolActor, fitness FitnessFunc) *AxolActor
+}
+
+// TournamentSelector picks K random candidates and returns the fittest.
+// Larger K increases selection pressure.
+type TournamentSelector struct {
+	K int
+}
+
+func (t TournamentSelector) Select(population []*AxolActor, fitness FitnessFunc) *AxolActor {
+	k := t.K
+	if k < 1 {
+		k = 1
+	}
+	best := population[rand.Intn(len(population))]
+	for i := 1; i < k; i++ {
+		candidate := population[rand.Intn(len(population))]
+		if fitness(candidate) > fitness(best) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// RouletteSelector picks a parent with probability proportional to its
+// fitness (fitness-proportional / "roulette wheel" selection).
+type RouletteSelector struct{}
+
+func (RouletteSelector) Select(population []*AxolActor, fitness FitnessFunc) *AxolActor {
+	total := 0.0
+	for _, a := range population {
+		total += fitness(a)
+	}
+	if total <= 0 {
+		return population[rand.Intn(len(population))]
+	}
+
+	pick := rand.Float64() * total
+	cumulative := 0.0
+	for _, a := range population {
+		cumulative += fitness(a)
+		if cumulative >= pick {
+			return a
+		}
+	}
+	return population[len(population)-1]
+}
+
+// RankSelector ranks the population by fitness and picks with probability
+// proportional to rank rather than raw fitness, which keeps selection
+// pressure steady even when fitness values are wildly skewed.
+type RankSelector struct{}
+
+func (RankSelector) Select(population []*AxolActor, fitness FitnessFunc) *AxolActor {
+	ranked := make([]*AxolActor, len(population))
+	copy(ranked, population)
+	sort.Slice(ranked, func(i, j int) bool {
+		return fitness(ranked[i]) < fitness(ranked[j])
+	})
+
+	n := len(ranked)
+	totalWeight := n * (n + 1) / 2
+	pick := rand.Intn(totalWeight)
+	cumulative := 0
+	for i, a := range ranked {
+		cumulative += i + 1
+		if cumulative > pick {
+			return a
+		}
+	}
+	return ranked[n-1]
+}
+
+// Crossover combines two parents' genomes into a child actor. The child is
+// not yet attached to an agent; evolvePopulation spawns one for it.
+type Crossover interface {
+	Cross(parent1, parent2 *AxolActor) *AxolActor
+}
+
+// UniformCrossover picks each gene independently from one parent or the
+// other with equal probability.
+type UniformCrossover struct{}
+
+func (UniformCrossover) Cross(parent1, parent2 *AxolActor) *AxolActor {
+	child := &AxolActor{species: parent1.species}
+	child.genome.size = pickGene(parent1.genome.size, parent2.genome.size)
+	child.genome.speed = pickGene(parent1.genome.speed, parent2.genome.speed)
+	child.genome.senseRadius = pickGene(parent1.genome.senseRadius, parent2.genome.senseRadius)
+	child.genome.sepWeight = pickGene(parent1.genome.sepWeight, parent2.genome.sepWeight)
+	child.genome.aliWeight = pickGene(parent1.genome.aliWeight, parent2.genome.aliWeight)
+	child.genome.cohWeight = pickGene(parent1.genome.cohWeight, parent2.genome.cohWeight)
+	child.genome.color = averageColor(parent1.genome.color, parent2.genome.color)
+	return child
+}
+
+func pickGene(g1, g2 float64) float64 {
+	if rand.Float64() < 0.5 {
+		return g1
+	}
+	return g2
+}
+
+// OnePointCrossover treats the genome as an ordered list of genes, picks a
+// single cut point, and takes genes before the cut from parent1 and genes
+// after it from parent2.
+type OnePointCrossover struct{}
+
+func (OnePointCrossover) Cross(parent1, parent2 *AxolActor) *AxolActor {
+	genes1 := genomeGenes(parent1.genome)
+	genes2 := genomeGenes(parent2.genome)
+
+	cut := rand.Intn(len(genes1) + 1)
+	genes := make([]float64, len(genes1))
+	for i := range genes {
+		if i < cut {
+			genes[i] = genes1[i]
+		} else {
+			genes[i] = genes2[i]
+		}
+	}
+
+	child := &AxolActor{species: parent1.species}
+	child.genome = genomeFromGenes(genes, averageColor(parent1.genome.color, parent2.genome.color))
+	return child
+}
+
+// BlendAlphaCrossover implements BLX-alpha: each child gene is sampled
+// uniformly from [min-alpha*d, max+alpha*d] where d = |p1-p2|, letting the
+// child explore slightly outside the parents' range.
+type BlendAlphaCrossover struct {
+	Alpha float64
+}
+
+func (b BlendAlphaCrossover) Cross(parent1, parent2 *AxolActor) *AxolActor {
+	blend := func(g1, g2 float64) float64 {
+		lo, hi := math.Min(g1, g2), math.Max(g1, g2)
+		d := hi - lo
+		return lo - b.Alpha*d + rand.Float64()*(hi-lo+2*b.Alpha*d)
+	}
+
+	child := &AxolActor{species: parent1.species}
+	child.genome.size = blend(parent1.genome.size, parent2.genome.size)
+	child.genome.speed = blend(parent1.genome.speed, parent2.genome.speed)
+	child.genome.senseRadius = blend(parent1.genome.senseRadius, parent2.genome.senseRadius)
+	child.genome.sepWeight = blend(parent1.genome.sepWeight, parent2.genome.sepWeight)
+	child.genome.aliWeight = blend(parent1.genome.aliWeight, parent2.genome.aliWeight)
+	child.genome.cohWeight = blend(parent1.genome.cohWeight, parent2.genome.cohWeight)
+	child.genome.color = averageColor(parent1.genome.color, parent2.genome.color)
+	return child
+}
+
+// genomeGenes and genomeFromGenes give OnePointCrossover a flat, ordered
+// view of the numeric genes, independent of the Genome struct's field order.
+func genomeGenes(g Genome) []float64 {
+	return []float64{g.size, g.speed, g.senseRadius, g.sepWeight, g.aliWeight, g.cohWeight}
+}
+
+func genomeFromGenes(genes []float64, c color.RGBA) Genome {
+	return Genome{
+		size:        genes[0],
+		speed:       genes[1],
+		senseRadius: genes[2],
+		sepWeight:   genes[3],
+		aliWeight:   genes[4],
+		cohWeight:   genes[5],
+		color:       c,
+	}
+}
+
+// MutationSigma holds the standard deviation of the Gaussian noise applied
+// to each gene during mutation.
+type MutationSigma struct {
+	Size, Speed, SenseRadius        float64
+	SepWeight, AliWeight, CohWeight float64
+}
+
+// mutateGaussian perturbs each numeric gene by Gaussian noise scaled by
+// sigma, clamping the genes that must stay positive. The colour gene gets
+// mutateColor's uniform jitter instead.
+func mutateGaussian(a *AxolActor, sigma MutationSigma) {
+	a.genome.size = math.Max(0.1, a.genome.size+rand.NormFloat64()*sigma.Size)
+	a.genome.speed = math.Max(1, a.genome.speed+rand.NormFloat64()*sigma.Speed)
+	a.genome.senseRadius = math.Max(1, a.genome.senseRadius+rand.NormFloat64()*sigma.SenseRadius)
+	a.genome.sepWeight += rand.NormFloat64() * sigma.SepWeight
+	a.genome.aliWeight += rand.NormFloat64() * sigma.AliWeight
+	a.genome.cohWeight += rand.NormFloat64() * sigma.CohWeight
+	a.genome.color = mutateColor(a.genome.color)
+}
+
+// GAConfig drives one generation of evolvePopulation: how parents are
+// picked, how their genomes combine, how children mutate, how many top
+// performers are preserved untouched, and how fitness is measured.
+type GAConfig struct {
+	Selector      Selector
+	Crossover     Crossover
+	Fitness       FitnessFunc
+	Elitism       int
+	MutationSigma MutationSigma
+}
+
+// DefaultGAConfig is the simulation's default tuning (tournament selection,
+// BLX-alpha crossover, modest Gaussian mutation, top 10% kept as elites),
+// built from the pluggable interfaces instead of hardcoded
+// top-half/average-gene logic.
+func DefaultGAConfig() GAConfig {
+	return GAConfig{
+		Selector:  TournamentSelector{K: 3},
+		Crossover: BlendAlphaCrossover{Alpha: 0.5},
+		Fitness:   consumedFoodFitness,
+		Elitism:   numAxols / 10,
+		MutationSigma: MutationSigma{
+			Size: 0.3, Speed: 3, SenseRadius: 2,
+			SepWeight: 0.1, AliWeight: 0.1, CohWeight: 0.1,
+		},
+	}
+}
+
+type individual struct {
+	agent *core.Agent
+	actor *AxolActor
+}
+
+// evolvePopulation replaces world's whole population with the next
+// generation: the top cfg.Elitism performers (by cfg.Fitness) survive
+// unchanged along with their agents, and the rest are bred by repeatedly
+// selecting two parents via cfg.Selector and combining them via
+// cfg.Crossover, then mutating and spawning the child.
+func evolvePopulation(world *core.World, cfg GAConfig) {
+	pop := make([]individual, len(world.Agents))
+	for i := range pop {
+		pop[i] = individual{agent: world.Agents[i], actor: world.Actor(i).(*AxolActor)}
+	}
+
+	sort.Slice(pop, func(i, j int) bool {
+		return cfg.Fitness(pop[i].actor) > cfg.Fitness(pop[j].actor)
+	})
+
+	actorPop := make([]*AxolActor, len(pop))
+	for i, ind := range pop {
+		actorPop[i] = ind.actor
+	}
+
+	elitism := cfg.Elitism
+	if elitism > len(pop) {
+		elitism = len(pop)
+	}
+
+	nextAgents := make([]*core.Agent, 0, len(pop))
+	nextActors := make([]core.Actor, 0, len(pop))
+	for i := 0; i < elitism; i++ {
+		nextAgents = append(nextAgents, pop[i].agent)
+		nextActors = append(nextActors, pop[i].actor)
+	}
+
+	for len(nextAgents) < len(pop) {
+		parent1 := cfg.Selector.Select(actorPop, cfg.Fitness)
+		parent2 := cfg.Selector.Select(actorPop, cfg.Fitness)
+		child := cfg.Crossover.Cross(parent1, parent2)
+		mutateGaussian(child, cfg.MutationSigma)
+
+		agent := core.NewAgent(
+			pixel.V(rand.Float64()*windowWidth, rand.Float64()*windowHeight),
+			child.genome.size, child.genome.color,
+		)
+		child.Setup(world.Env, agent)
+
+		nextAgents = append(nextAgents, agent)
+		nextActors = append(nextActors, child)
+	}
+
+	for _, actor := range nextActors {
+		a := actor.(*AxolActor)
+		a.consumedFood = 0
+		a.timeSinceLast = 0
+	}
+
+	world.Reset(nextAgents, nextActors)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/SourceAura/Axols/core"
+	"github.com/SourceAura/Axols/recorder"
+)
+
+// headlessDT is the fixed simulated timestep used in headless mode. There's
+// no window to vsync against, so generations are advanced as fast as the
+// CPU allows by stepping a constant dt instead of a wall-clock one.
+const headlessDT = 1.0 / 60.0
+
+// runHeadless simulates generations generations with no pixelgl window,
+// recording one CSV row per axol and one aggregate row per species per
+// generation (plus, if plotPNG is set, a population scatter PNG) under
+// outDir. This makes the evolution loop reproducible and scriptable for
+// parameter sweeps instead of only watchable.
+func runHeadless(generations int, outDir string, plotPNG bool) error {
+	rec, err := recorder.New(outDir, plotPNG)
+	if err != nil {
+		return fmt.Errorf("headless: %w", err)
+	}
+	defer rec.Close()
+
+	world := newPopulation(numAxols)
+	gaConfig := DefaultGAConfig()
+
+	for generation := 0; generation < generations; generation++ {
+		generationTime := 0.0
+		for generationTime < generationDuration {
+			advanceSimulation(world, headlessDT)
+			world.Step(headlessDT)
+			generationTime += headlessDT
+		}
+
+		if err := rec.RecordGeneration(generation, snapshotWorld(world)); err != nil {
+			return fmt.Errorf("headless: %w", err)
+		}
+
+		evolvePopulation(world, gaConfig)
+		foods = nil
+	}
+
+	return nil
+}
+
+// snapshotWorld copies the fields the recorder needs out of the live
+// world, so the recorder package has no dependency on the simulation types.
+func snapshotWorld(world *core.World) []recorder.AxolSnapshot {
+	snaps := make([]recorder.AxolSnapshot, len(world.Agents))
+	for i, agent := range world.Agents {
+		actor := world.Actor(i).(*AxolActor)
+		snaps[i] = recorder.AxolSnapshot{
+			Species:      actor.species,
+			Size:         actor.genome.size,
+			Speed:        actor.genome.speed,
+			SenseRadius:  actor.genome.senseRadius,
+			R:            actor.genome.color.R,
+			G:            actor.genome.color.G,
+			B:            actor.genome.color.B,
+			ConsumedFood: actor.consumedFood,
+			PosX:         agent.Pos.X,
+			PosY:         agent.Pos.Y,
+		}
+	}
+	return snaps
+}
@@ -0,0 +1,673 @@
+// Command axols runs the Axol evolution simulation on top of the shared
+// core turtle/environment/actor model: axols are core.Agents driven by
+// AxolActor, which senses and deposits into the environment's pheromone
+// field and competes for food across generations via a genetic algorithm.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+
+	"github.com/SourceAura/Axols/core"
+	"github.com/SourceAura/Axols/plot"
+	"github.com/SourceAura/Axols/spatial"
+)
+
+const (
+	windowWidth        = 900
+	windowHeight       = 700
+	numAxols           = 100 // Reduced number for clarity
+	minRadius          = 1
+	maxRadius          = 5
+	outlineWidth       = 0.5
+	pheromoneSpread    = 2
+	pheromoneIntensity = 1.0  // Initial intensity of the pheromone trail
+	pheromoneDecay     = 0.05 // Rate at which pheromone intensity decreases over time
+	pheromoneAlpha     = 200  // Multiplier from pheromone concentration to overlay alpha
+	pheromoneCellSize  = 10   // Size in pixels of one pheromone grid cell
+	steerRate          = 4.0  // How fast an axol's heading turns toward a stronger scent
+	sensorAngle        = math.Pi / 4 // angle between forward and left/right sensors
+	initialSpeedFactor = 0.5  // Factor to slow down initial movement speed
+	nucleusRadius      = 0.3  // Radius of the nucleus
+	foodSpawnRate      = 0.01 // Probability of food spawning per frame
+	consumeRadius      = 10   // Radius within which a particle can consume food
+	nutritionPerFood   = 1    // Amount of nutrition gained per unit of food
+	evolutionSpeedup   = 0.1  // Speedup factor for evolution due to consuming food
+	generationDuration = 10.0 // Duration of each generation in seconds
+)
+
+// Biome represents a separate environment within the simulation
+type Biome int
+
+const (
+	Overworld Biome = iota
+	BubbleBiome1
+	BubbleBiome2
+	BubbleBiome3
+)
+
+// Species identifies which steering behaviour an axol follows.
+const (
+	SpeciesAxolBlue = iota // pheromone-seeking, species 0 in the original model
+	SpeciesAxolPink        // pheromone-seeking, species 1 in the original model
+	SpeciesBird            // Reynolds boids: separation, alignment, cohesion
+)
+
+// Genome holds the heritable traits a GAConfig selects, crosses, and
+// mutates across generations.
+type Genome struct {
+	size        float64
+	speed       float64
+	senseRadius float64
+	color       color.RGBA
+
+	// Boid weights, only meaningful for SpeciesBird; genome-encoded so the
+	// evolution loop can tune flocking behaviour like any other trait.
+	sepWeight float64 // separation: repulsion from crowded neighbours
+	aliWeight float64 // alignment: matching neighbour heading
+	cohWeight float64 // cohesion: steering toward the flock centroid
+}
+
+// Food represents a source of nutrition for axols.
+type Food struct {
+	pos       pixel.Vec // Position
+	radius    float64   // Radius
+	color     color.RGBA
+	biome     Biome   // Biome the food belongs to
+	nutrition float64 // Nutrition value of the food
+}
+
+// NewFood creates a new food source with random properties, resampling its
+// position until it lands outside any obstacle wall in env.
+func NewFood(env *core.Environment) Food {
+	var pos pixel.Vec
+	for {
+		pos = pixel.V(rand.Float64()*windowWidth, rand.Float64()*windowHeight)
+		if !env.Blocked(pos.X, pos.Y) {
+			break
+		}
+	}
+	radius := rand.Float64()*5 + 3
+	col := randomColor()
+	nutrition := rand.Float64() * 10
+	biome := Biome(rand.Intn(4))
+	return Food{pos, radius, col, biome, nutrition}
+}
+
+func randomColor() color.RGBA {
+	return color.RGBA{
+		R: uint8(rand.Intn(256)),
+		G: uint8(rand.Intn(256)),
+		B: uint8(rand.Intn(256)),
+		A: 150, // Less transparency
+	}
+}
+
+func mutateColor(c color.RGBA) color.RGBA {
+	return color.RGBA{
+		R: uint8(math.Max(0, math.Min(255, float64(c.R)+rand.Float64()*20-10))),
+		G: uint8(math.Max(0, math.Min(255, float64(c.G)+rand.Float64()*20-10))),
+		B: uint8(math.Max(0, math.Min(255, float64(c.B)+rand.Float64()*20-10))),
+		A: c.A,
+	}
+}
+
+func averageColor(c1, c2 color.RGBA) color.RGBA {
+	return color.RGBA{
+		R: uint8((int(c1.R) + int(c2.R)) / 2),
+		G: uint8((int(c1.G) + int(c2.G)) / 2),
+		B: uint8((int(c1.B) + int(c2.B)) / 2),
+		A: uint8((int(c1.A) + int(c2.A)) / 2),
+	}
+}
+
+// AxolActor is the core.Actor driving one axol: it senses and deposits
+// pheromone (or flocks, for SpeciesBird), consumes nearby food, and tracks
+// the per-generation stats the GA selects on.
+type AxolActor struct {
+	genome        Genome
+	species       int
+	tailAngle     float64
+	consumedFood  int
+	timeSinceLast float64
+}
+
+// speciesLabel names a species for debug output and HUD legends.
+func speciesLabel(species int) string {
+	switch species {
+	case SpeciesAxolBlue:
+		return "blue"
+	case SpeciesAxolPink:
+		return "pink"
+	default:
+		return "bird"
+	}
+}
+
+// speciesColor is the HUD's series colour for species, matching its default
+// genome colour so a chart's legend reads the same as the axols on screen.
+func speciesColor(species int) color.RGBA {
+	return newAxolActor(species).genome.color
+}
+
+// lighten washes out c toward white, used to tell a "best" series apart
+// from its species' "mean" series on the same chart.
+func lighten(c color.RGBA) color.RGBA {
+	return color.RGBA{
+		R: uint8(float64(c.R) + (255-float64(c.R))*0.5),
+		G: uint8(float64(c.G) + (255-float64(c.G))*0.5),
+		B: uint8(float64(c.B) + (255-float64(c.B))*0.5),
+		A: c.A,
+	}
+}
+
+// newAxolActor builds a species' default genome, mirroring the simulation's
+// original per-species tuning.
+func newAxolActor(species int) *AxolActor {
+	var genome Genome
+	switch species {
+	case SpeciesAxolBlue:
+		genome = Genome{
+			size:        5,
+			speed:       50,
+			senseRadius: 30,
+			color:       color.RGBA{R: 100, G: 200, B: 255, A: 150},
+		}
+	case SpeciesAxolPink:
+		genome = Genome{
+			size:        7,
+			speed:       40,
+			senseRadius: 40,
+			color:       color.RGBA{R: 255, G: 100, B: 200, A: 150},
+		}
+	default: // SpeciesBird
+		genome = Genome{
+			size:        3,
+			speed:       70,
+			senseRadius: 60,
+			color:       color.RGBA{R: 240, G: 230, B: 140, A: 200},
+			sepWeight:   1.5,
+			aliWeight:   1.0,
+			cohWeight:   0.8,
+		}
+	}
+	return &AxolActor{genome: genome, species: species}
+}
+
+// spawnAxol creates a new agent/actor pair for species at a random position
+// outside any obstacle wall and adds it to world.
+func spawnAxol(world *core.World, species int) {
+	actor := newAxolActor(species)
+	var pos pixel.Vec
+	for {
+		pos = pixel.V(rand.Float64()*windowWidth, rand.Float64()*windowHeight)
+		if !world.Env.Blocked(pos.X, pos.Y) {
+			break
+		}
+	}
+	agent := core.NewAgent(pos, actor.genome.size, actor.genome.color)
+	world.Spawn(agent, actor)
+}
+
+func (a *AxolActor) Setup(env *core.Environment, agent *core.Agent) {
+	agent.Radius = a.genome.size
+	agent.Color = a.genome.color
+	agent.Vel = pixel.V(rand.Float64()*2-1, rand.Float64()*2-1).Unit().Scaled(a.genome.speed)
+	agent.Heading = math.Atan2(agent.Vel.Y, agent.Vel.X)
+}
+
+// Step moves the axol one tick: species-dependent steering, then movement,
+// trail deposition, and food consumption via the shared food index.
+func (a *AxolActor) Step(env *core.Environment, agent *core.Agent, dt float64) {
+	if a.species == SpeciesBird {
+		a.flock(env, agent, dt)
+	} else {
+		a.steer(env, agent, dt)
+	}
+
+	agent.Vel = pixel.V(math.Cos(agent.Heading), math.Sin(agent.Heading)).Scaled(a.genome.speed)
+	moveWithCollision(env, agent, dt)
+
+	if agent.Pos.X < env.Bounds.Min.X || agent.Pos.X > env.Bounds.Max.X {
+		agent.Vel.X = -agent.Vel.X
+		agent.Heading = math.Atan2(agent.Vel.Y, agent.Vel.X)
+	}
+	if agent.Pos.Y < env.Bounds.Min.Y || agent.Pos.Y > env.Bounds.Max.Y {
+		agent.Vel.Y = -agent.Vel.Y
+		agent.Heading = math.Atan2(agent.Vel.Y, agent.Vel.X)
+	}
+	a.tailAngle += 6 * dt // Reduced from 10 to 6 to slow down the animation
+	a.timeSinceLast += dt
+
+	if a.species != SpeciesBird {
+		env.Pheromone.Deposit(agent.Pos.X, agent.Pos.Y, "trail", pheromoneIntensity*a.genome.size*dt)
+	}
+
+	a.consumeNearbyFood(agent)
+}
+
+// moveWithCollision advances agent by its velocity for dt, sliding along
+// obstacle walls instead of tunnelling through them: if the full diagonal
+// move is blocked, the X-only and Y-only moves are tried independently and
+// whichever axis is clear is taken, with the blocked axis's velocity
+// reflected. If both axes are blocked (a corner), the agent stays put and
+// bounces back the way it came. Each candidate position is tested with the
+// agent's full body circle, not just its center point, so an axol can't
+// clip a wall corner or sink into a tile by up to its radius.
+func moveWithCollision(env *core.Environment, agent *core.Agent, dt float64) {
+	delta := agent.Vel.Scaled(dt)
+	next := agent.Pos.Add(delta)
+
+	switch {
+	case !circleBlocked(env, next.X, next.Y, agent.Radius):
+		agent.Pos = next
+	case !circleBlocked(env, next.X, agent.Pos.Y, agent.Radius):
+		agent.Pos.X = next.X
+		agent.Vel.Y = -agent.Vel.Y
+	case !circleBlocked(env, agent.Pos.X, next.Y, agent.Radius):
+		agent.Pos.Y = next.Y
+		agent.Vel.X = -agent.Vel.X
+	default:
+		agent.Vel = agent.Vel.Scaled(-1)
+	}
+	agent.Heading = math.Atan2(agent.Vel.Y, agent.Vel.X)
+}
+
+// circleBlockedSamples is how many points around the circumference
+// circleBlocked tests, in addition to the center; enough to catch a wall
+// edge clipping the body without the cost of a per-pixel scan.
+const circleBlockedSamples = 8
+
+// circleBlocked reports whether any point of the agent's body — approximated
+// by its center plus circleBlockedSamples points around a circle of the
+// given radius — falls in a wall tile, so a move is only accepted once the
+// whole body clears the obstacle, not just its center.
+func circleBlocked(env *core.Environment, x, y, radius float64) bool {
+	if env.Blocked(x, y) {
+		return true
+	}
+	for i := 0; i < circleBlockedSamples; i++ {
+		angle := float64(i) * 2 * math.Pi / circleBlockedSamples
+		if env.Blocked(x+math.Cos(angle)*radius, y+math.Sin(angle)*radius) {
+			return true
+		}
+	}
+	return false
+}
+
+// steer samples the "food" and "trail" channels at three sensor points —
+// forward, forward-left and forward-right, each at senseRadius — and
+// rotates the heading toward whichever reads strongest. This is the
+// classic Physarum/ant trisensor rule: food draws axols toward nutrition,
+// while trail is the axol's own deposited scent, so a path another axol
+// (or this one, earlier) walked keeps drawing followers along it.
+func (a *AxolActor) steer(env *core.Environment, agent *core.Agent, dt float64) {
+	radius := a.genome.senseRadius
+	field := env.Pheromone
+
+	sense := func(angle float64) float64 {
+		return field.Sense(agent.Pos.X, agent.Pos.Y, angle, radius, "food") +
+			field.Sense(agent.Pos.X, agent.Pos.Y, angle, radius, "trail")
+	}
+	center := sense(agent.Heading)
+	left := sense(agent.Heading + sensorAngle)
+	right := sense(agent.Heading - sensorAngle)
+
+	switch {
+	case center >= left && center >= right:
+		// Already pointed at the strongest scent; keep heading.
+	case left > right:
+		agent.Heading += steerRate * dt
+	default:
+		agent.Heading -= steerRate * dt
+	}
+
+	// Nothing detected in any direction: wander randomly instead of
+	// settling on a fixed heading.
+	if center == 0 && left == 0 && right == 0 {
+		agent.Heading += (rand.Float64()*2 - 1) * steerRate * dt
+	}
+}
+
+// flock steers a bird using the classic Reynolds boids rules over the
+// other birds within senseRadius, found via the environment's agent index:
+// separation (sum of inverse-distance repulsion), alignment (average
+// neighbour velocity) and cohesion (steer toward the neighbour centroid),
+// each scaled by a genome-encoded weight.
+func (a *AxolActor) flock(env *core.Environment, agent *core.Agent, dt float64) {
+	var separation, velocitySum, positionSum pixel.Vec
+	neighbours := 0
+	for _, other := range env.Neighbors(agent.Pos, a.genome.senseRadius) {
+		if other == agent {
+			continue
+		}
+		otherActor, ok := otherActorOf(other)
+		if !ok || otherActor.species != a.species {
+			continue
+		}
+		toOther := agent.Pos.To(other.Pos)
+		dist := toOther.Len()
+		if dist == 0 || dist > a.genome.senseRadius {
+			continue
+		}
+		separation = separation.Sub(toOther.Scaled(1 / (dist * dist)))
+		velocitySum = velocitySum.Add(other.Vel)
+		positionSum = positionSum.Add(other.Pos)
+		neighbours++
+	}
+
+	if neighbours == 0 {
+		agent.Heading += (rand.Float64()*2 - 1) * steerRate * dt
+		return
+	}
+
+	alignment := velocitySum.Scaled(1 / float64(neighbours))
+	centroid := positionSum.Scaled(1 / float64(neighbours))
+	cohesion := agent.Pos.To(centroid)
+
+	steer := separation.Scaled(a.genome.sepWeight).
+		Add(alignment.Scaled(a.genome.aliWeight)).
+		Add(cohesion.Scaled(a.genome.cohWeight))
+
+	if steer.Len() > 0 {
+		agent.Heading = turnToward(agent.Heading, math.Atan2(steer.Y, steer.X), steerRate*dt)
+	}
+}
+
+// turnToward rotates current toward target by at most maxDelta, taking the
+// shorter way around the circle.
+func turnToward(current, target, maxDelta float64) float64 {
+	diff := math.Mod(target-current+math.Pi, 2*math.Pi) - math.Pi
+	if diff < -math.Pi {
+		diff += 2 * math.Pi
+	}
+	if diff < -maxDelta {
+		diff = -maxDelta
+	} else if diff > maxDelta {
+		diff = maxDelta
+	}
+	return current + diff
+}
+
+// Draw draws the axol's body, nucleus, and wiggling tail.
+func (a *AxolActor) Draw(imd *imdraw.IMDraw, agent *core.Agent) {
+	imd.Color = agent.Color
+	imd.Push(agent.Pos)
+	imd.Circle(a.genome.size, 0)
+
+	nucleusColor := color.RGBA{R: 255, G: 255, B: 255, A: 200}
+	imd.Color = nucleusColor
+	imd.Push(agent.Pos)
+	imd.Circle(a.genome.size/3, 0)
+
+	tailLength := a.genome.size * 3
+	tailSegments := 20
+	waveFrequency := 2.0
+	maxAmplitude := a.genome.size * 0.25
+
+	imd.Color = agent.Color
+	for i := 0; i <= tailSegments; i++ {
+		t := float64(i) / float64(tailSegments)
+		segmentPos := agent.Pos.Add(agent.Vel.Unit().Scaled(-t * tailLength))
+
+		wiggleOffset := math.Sin(a.tailAngle+(1-t)*waveFrequency*math.Pi) * maxAmplitude * t
+		segmentPos = segmentPos.Add(agent.Vel.Normal().Scaled(wiggleOffset))
+
+		imd.Push(segmentPos)
+	}
+	imd.Line(a.genome.size * 0.2)
+}
+
+// otherActorOf looks up the AxolActor behind other, used by flock to read
+// a neighbour's species. The environment only knows agents, not actors, so
+// this consults a lookup rebuilt each tick instead.
+func otherActorOf(other *core.Agent) (*AxolActor, bool) {
+	actor, ok := actorLookup[other]
+	return actor, ok
+}
+
+// actorLookup is refreshed every tick in advanceSimulation, mapping each
+// live agent back to its AxolActor so Step/flock can read a neighbour's
+// genome without the environment needing to know about actors.
+var actorLookup = map[*core.Agent]*AxolActor{}
+
+func rebuildActorLookup(world *core.World) {
+	for k := range actorLookup {
+		delete(actorLookup, k)
+	}
+	for i, agent := range world.Agents {
+		if actor, ok := world.Actor(i).(*AxolActor); ok {
+			actorLookup[agent] = actor
+		}
+	}
+}
+
+func (a *AxolActor) consumeNearbyFood(agent *core.Agent) {
+	if foodIndex == nil {
+		return
+	}
+	window := pixel.R(
+		agent.Pos.X-consumeRadius, agent.Pos.Y-consumeRadius,
+		agent.Pos.X+consumeRadius, agent.Pos.Y+consumeRadius,
+	)
+	for _, pt := range foodIndex.SearchArea(window) {
+		food, ok := pt.Data.(*Food)
+		if !ok {
+			continue
+		}
+		if agent.Pos.To(food.pos).Len() <= consumeRadius {
+			a.consumedFood++
+			a.timeSinceLast = 0
+			break
+		}
+	}
+}
+
+// foods and foodIndex are the axol-specific world state core doesn't need
+// to know about; they're rebuilt once per tick in advanceSimulation.
+var (
+	foods     []Food
+	foodIndex *spatial.Quadtree
+)
+
+// mapName selects which obstacle map (see maps.go) newPopulation loads into
+// a fresh Environment; set from the -map flag before the world is created.
+var mapName = "arena"
+
+// hudHistory is how many generations of history each HUD chart keeps.
+const hudHistory = 100
+
+// hudTitles are the HUD's charts, in stacked display order.
+var hudTitles = []string{"fitness", "size", "speed", "senseRadius", "color"}
+
+// hud is the live generation-over-generation stats overlay; nil in headless
+// mode, which has no window to draw it on.
+var hud *plot.HUD
+
+// updateDebugInfo aggregates the current population into per-species mean
+// and best stats, prints them (as the original console debug display did)
+// and records them onto hud so the trend is visible without reading the
+// console. Called once per generation, just before evolvePopulation retires
+// this population.
+func updateDebugInfo(world *core.World, generation int) {
+	type stats struct {
+		count                       int
+		fitnessSum, bestFitness     float64
+		sizeSum, speedSum, senseSum float64
+	}
+	bySpecies := map[int]*stats{}
+	var rSum, gSum, bSum float64
+
+	for i, agent := range world.Agents {
+		actor, ok := world.Actor(i).(*AxolActor)
+		if !ok {
+			continue
+		}
+		s := bySpecies[actor.species]
+		if s == nil {
+			s = &stats{}
+			bySpecies[actor.species] = s
+		}
+		fitness := consumedFoodFitness(actor)
+		s.count++
+		s.fitnessSum += fitness
+		if fitness > s.bestFitness {
+			s.bestFitness = fitness
+		}
+		s.sizeSum += actor.genome.size
+		s.speedSum += actor.genome.speed
+		s.senseSum += actor.genome.senseRadius
+
+		rSum += float64(agent.Color.R)
+		gSum += float64(agent.Color.G)
+		bSum += float64(agent.Color.B)
+	}
+
+	fmt.Printf("generation %d:\n", generation)
+	for species := SpeciesAxolBlue; species <= SpeciesBird; species++ {
+		s := bySpecies[species]
+		if s == nil || s.count == 0 {
+			continue
+		}
+		label := speciesLabel(species)
+		meanFitness := s.fitnessSum / float64(s.count)
+		meanSize := s.sizeSum / float64(s.count)
+		meanSpeed := s.speedSum / float64(s.count)
+		meanSense := s.senseSum / float64(s.count)
+
+		fmt.Printf("  %s: n=%d mean_fitness=%.2f best_fitness=%.2f mean_size=%.2f mean_speed=%.2f mean_sense=%.2f\n",
+			label, s.count, meanFitness, s.bestFitness, meanSize, meanSpeed, meanSense)
+
+		if hud == nil {
+			continue
+		}
+		col := speciesColor(species)
+		hud.Record("fitness", label+" mean", col, meanFitness)
+		hud.Record("fitness", label+" best", lighten(col), s.bestFitness)
+		hud.Record("size", label, col, meanSize)
+		hud.Record("speed", label, col, meanSpeed)
+		hud.Record("senseRadius", label, col, meanSense)
+	}
+
+	if hud != nil && len(world.Agents) > 0 {
+		n := float64(len(world.Agents))
+		hud.Record("color", "R", color.RGBA{R: 255, A: 255}, rSum/n)
+		hud.Record("color", "G", color.RGBA{G: 255, A: 255}, gSum/n)
+		hud.Record("color", "B", color.RGBA{B: 255, A: 255}, bSum/n)
+	}
+}
+
+// advanceSimulation refreshes the food scent, diffuses the pheromone
+// field, reindexes food, rebuilds actorLookup, and spawns new food (the
+// agents' spatial index is rebuilt by World.Step itself). It runs before
+// World.Step each tick (core.RunConfig.OnBeforeStep / the headless
+// equivalent), so AxolActor.Step always sees this tick's state.
+func advanceSimulation(world *core.World, dt float64) {
+	for i := range foods {
+		world.Env.Pheromone.Deposit(foods[i].pos.X, foods[i].pos.Y, "food", foods[i].nutrition*dt)
+	}
+	world.Env.Pheromone.Step(dt)
+
+	foodIndex = spatial.NewQuadtree(world.Env.Bounds)
+	for i := range foods {
+		foodIndex.Insert(spatial.Point{Pos: foods[i].pos, Data: &foods[i]})
+	}
+
+	rebuildActorLookup(world)
+
+	if rand.Float64() < foodSpawnRate {
+		foods = append(foods, NewFood(world.Env))
+	}
+}
+
+func drawFoodSources(imd *imdraw.IMDraw, foods []Food) {
+	for _, f := range foods {
+		imd.Color = f.color
+		imd.Push(f.pos)
+		imd.Circle(f.radius, 0)
+	}
+}
+
+func newPopulation(n int) *core.World {
+	bounds := pixel.R(0, 0, windowWidth, windowHeight)
+	env := core.NewEnvironment(bounds)
+	env.Pheromone = core.NewPheromoneField(windowWidth, windowHeight, pheromoneCellSize, pheromoneSpread, pheromoneDecay, "food", "trail")
+	env.Obstacles = loadExampleMap(mapName)
+
+	world := core.NewWorld(env)
+	for i := 0; i < n; i++ {
+		spawnAxol(world, i%3)
+	}
+	return world
+}
+
+func run() {
+	world := newPopulation(numAxols)
+	gaConfig := DefaultGAConfig()
+	deepPurple := color.RGBA{R: 20, G: 0, B: 30, A: 255}
+
+	hudRegion := pixel.R(windowWidth-180, windowHeight-260, windowWidth-10, windowHeight-10)
+	hud = plot.NewHUD(hudRegion, hudTitles, hudHistory)
+
+	generation := 0
+	generationTime := 0.0
+
+	core.Run(core.RunConfig{
+		Title:      "Axol Simulation",
+		Bounds:     world.Env.Bounds,
+		Background: deepPurple,
+		World:      world,
+		OnBeforeStep: func(dt float64) {
+			advanceSimulation(world, dt)
+		},
+		OnTick: func(dt float64) {
+			generationTime += dt
+			if generationTime >= generationDuration {
+				updateDebugInfo(world, generation)
+				evolvePopulation(world, gaConfig)
+				generation++
+				generationTime = 0
+				foods = nil
+			}
+		},
+		OnDraw: func(imd *imdraw.IMDraw) {
+			if world.Env.Obstacles != nil {
+				world.Env.Obstacles.Draw(imd, wallColor)
+			}
+			world.Env.Pheromone.Draw(imd, "food", color.RGBA{R: 80, G: 220, B: 120, A: 255}, pheromoneAlpha, 120)
+			world.Env.Pheromone.Draw(imd, "trail", color.RGBA{R: 220, G: 200, B: 255, A: 255}, pheromoneAlpha, 90)
+			drawFoodSources(imd, foods)
+			hud.Draw(imd)
+		},
+	})
+}
+
+func main() {
+	headless := flag.Bool("headless", false, "run without a window, as fast as possible")
+	generations := flag.Int("generations", 50, "number of generations to simulate in headless mode")
+	outDir := flag.String("out", "runs", "directory to write CSV/PNG output to in headless mode")
+	plotPNG := flag.Bool("plot", false, "dump a population scatter PNG each generation (headless mode only)")
+	mapFlag := flag.String("map", "arena", "obstacle map to run: arena, maze, rooms, or none")
+	seed := flag.Int64("seed", 1, "PRNG seed for headless mode, so the same flags reproduce the same run; ignored with a window")
+	flag.Parse()
+
+	mapName = *mapFlag
+
+	if *headless {
+		rand.Seed(*seed)
+		if err := runHeadless(*generations, *outDir, *plotPNG); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	rand.Seed(time.Now().UnixNano())
+	run()
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/SourceAura/Axols/core"
+)
+
+// Obstacle tile grid dimensions, sized so mapCols*mapTileSize and
+// mapRows*mapTileSize roughly cover the window.
+const (
+	mapCols     = windowWidth / 30
+	mapRows     = windowHeight / 30
+	mapTileSize = 30.0
+)
+
+// wallColor is the fill for obstacle tiles drawn by OnDraw.
+var wallColor = color.RGBA{R: 90, G: 90, B: 100, A: 255}
+
+// exampleMaps are the built-in obstacle layouts selectable via the -map
+// flag; "none" (or any unrecognised name) leaves Environment.Obstacles nil.
+var exampleMaps = map[string]func() [][]int{
+	"arena": buildArenaTiles,
+	"maze":  buildMazeTiles,
+	"rooms": buildRoomsTiles,
+}
+
+// loadExampleMap builds the named map's TileMap, or nil if name isn't one
+// of exampleMaps (including the "none" opt-out).
+func loadExampleMap(name string) *core.TileMap {
+	build, ok := exampleMaps[name]
+	if !ok {
+		return nil
+	}
+	return core.NewTileMap(build(), mapTileSize)
+}
+
+// bordered returns a cols x rows grid walled in along its outer edge, open
+// everywhere else - the common base every example map builds on.
+func bordered(cols, rows int) [][]int {
+	tiles := make([][]int, rows)
+	for r := range tiles {
+		tiles[r] = make([]int, cols)
+		for c := range tiles[r] {
+			if r == 0 || r == rows-1 || c == 0 || c == cols-1 {
+				tiles[r][c] = 1
+			}
+		}
+	}
+	return tiles
+}
+
+// buildArenaTiles is an open arena: just the bounding wall, nothing inside
+// to get tangled on.
+func buildArenaTiles() [][]int {
+	return bordered(mapCols, mapRows)
+}
+
+// buildMazeTiles adds two long interior walls, each with a single gap offset
+// from the other, forcing a zig-zag path from one side of the arena to the
+// other.
+func buildMazeTiles() [][]int {
+	tiles := bordered(mapCols, mapRows)
+
+	row1, gap1 := mapRows/3, mapCols/4
+	row2, gap2 := 2*mapRows/3, 3*mapCols/4
+	for c := 1; c < mapCols-1; c++ {
+		if c != gap1 {
+			tiles[row1][c] = 1
+		}
+		if c != gap2 {
+			tiles[row2][c] = 1
+		}
+	}
+	return tiles
+}
+
+// buildRoomsTiles splits the arena into two rooms with a single-tile
+// corridor gap connecting them.
+func buildRoomsTiles() [][]int {
+	tiles := bordered(mapCols, mapRows)
+
+	mid, gapRow := mapCols/2, mapRows/2
+	for r := 1; r < mapRows-1; r++ {
+		if r != gapRow {
+			tiles[r][mid] = 1
+		}
+	}
+	return tiles
+}
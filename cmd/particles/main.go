@@ -0,0 +1,94 @@
+// Command particles runs the particle-life simulation: a cloud of
+// colourful dots bouncing around the window. It's the core package's
+// simplest Actor, useful as a reference for writing new ones.
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math/rand"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"golang.org/x/image/colornames"
+
+	"github.com/SourceAura/Axols/core"
+)
+
+const (
+	windowWidth  = 1100
+	windowHeight = 800
+
+	numParticles = 500
+	minRadius    = 1
+	maxRadius    = 5
+	centerSize   = 2
+	outlineWidth = 0.5
+)
+
+// ParticleActor drifts its agent at a constant velocity, bouncing off the
+// window edges.
+type ParticleActor struct{}
+
+func (ParticleActor) Setup(env *core.Environment, agent *core.Agent) {
+	agent.Vel = pixel.V(rand.Float64()*100-50, rand.Float64()*100-50)
+}
+
+func (ParticleActor) Step(env *core.Environment, agent *core.Agent, dt float64) {
+	agent.Pos = agent.Pos.Add(agent.Vel.Scaled(dt))
+	if agent.Pos.X < env.Bounds.Min.X || agent.Pos.X > env.Bounds.Max.X {
+		agent.Vel.X = -agent.Vel.X
+	}
+	if agent.Pos.Y < env.Bounds.Min.Y || agent.Pos.Y > env.Bounds.Max.Y {
+		agent.Vel.Y = -agent.Vel.Y
+	}
+}
+
+// Draw draws the particle as a small filled center with a white outline
+// ring, matching the original particle-life look.
+func (ParticleActor) Draw(imd *imdraw.IMDraw, agent *core.Agent) {
+	imd.Color = agent.Color
+	imd.Push(agent.Pos)
+	imd.Circle(centerSize, 0)
+	imd.Polygon(0)
+
+	imd.Color = colornames.White
+	imd.Push(agent.Pos)
+	imd.Circle(agent.Radius, outlineWidth)
+}
+
+func randomColor() color.RGBA {
+	return color.RGBA{
+		R: uint8(rand.Intn(256)),
+		G: uint8(rand.Intn(256)),
+		B: uint8(rand.Intn(256)),
+		A: 150, // Less transparency
+	}
+}
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+
+	bounds := pixel.R(0, 0, windowWidth, windowHeight)
+	world := core.NewWorld(core.NewEnvironment(bounds))
+
+	for i := 0; i < numParticles; i++ {
+		radius := rand.Float64()*(maxRadius-minRadius) + minRadius
+		pos := pixel.V(rand.Float64()*windowWidth, rand.Float64()*windowHeight)
+		agent := core.NewAgent(pos, radius, randomColor())
+		world.Spawn(agent, ParticleActor{})
+	}
+
+	core.Run(core.RunConfig{
+		Title:      "Particle Life Simulation",
+		Bounds:     bounds,
+		Background: color.Black,
+		World:      world,
+		OnTick: func(dt float64) {
+			// Print debugging/logging information
+			print("\033[H\033[2J")
+			fmt.Println("Number of particles:", len(world.Agents))
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package core
+
+import "github.com/faiface/pixel/imdraw"
+
+// Actor supplies the behaviour driving one Agent. Setup runs once when the
+// agent is spawned (to set its initial velocity, color, Data, ...); Step
+// runs every tick to update it.
+type Actor interface {
+	Setup(env *Environment, agent *Agent)
+	Step(env *Environment, agent *Agent, dt float64)
+}
+
+// Drawer is an optional extension an Actor can implement to draw its agent
+// differently from the renderer's default translucent circle (e.g. the
+// axol's wiggling tail, or a particle's outline ring).
+type Drawer interface {
+	Draw(imd *imdraw.IMDraw, agent *Agent)
+}
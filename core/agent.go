@@ -0,0 +1,32 @@
+// Package core provides a small turtle/environment/actor model shared by
+// every simulation in this repo: a core.Agent holds the generic per-entity
+// state (position, velocity, heading, appearance), a core.Environment holds
+// shared world state (bounds, pheromone field, spatial index), and an Actor
+// implementation supplies the behaviour that decides how an agent moves.
+package core
+
+import (
+	"image/color"
+
+	"github.com/faiface/pixel"
+)
+
+// Agent is the generic "turtle": the state every simulated entity has
+// regardless of what behaviour drives it. Actor-specific state (a genome,
+// a species, food eaten so far, ...) is kept by that agent's Actor, either
+// on the Actor value itself or in Data.
+type Agent struct {
+	Pos     pixel.Vec
+	Vel     pixel.Vec
+	Heading float64
+	Radius  float64
+	Color   color.RGBA
+
+	Data interface{}
+}
+
+// NewAgent creates an agent at pos with the given radius and color. Vel and
+// Heading default to zero; most actors set them in Setup.
+func NewAgent(pos pixel.Vec, radius float64, c color.RGBA) *Agent {
+	return &Agent{Pos: pos, Radius: radius, Color: c}
+}
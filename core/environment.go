@@ -0,0 +1,76 @@
+package core
+
+import (
+	"github.com/faiface/pixel"
+
+	"github.com/SourceAura/Axols/spatial"
+)
+
+// Environment is the shared world state every agent in a World can read:
+// its bounds, an optional pheromone field, and a spatial index of every
+// agent, rebuilt once per tick so Actors never need to scan the whole
+// population themselves.
+type Environment struct {
+	Bounds    pixel.Rect
+	Pheromone *PheromoneField
+	Obstacles *TileMap
+
+	agentIndex *spatial.Quadtree
+}
+
+// NewEnvironment creates an environment over bounds. Pheromone is left nil;
+// set it directly if the simulation uses one.
+func NewEnvironment(bounds pixel.Rect) *Environment {
+	return &Environment{Bounds: bounds}
+}
+
+// Blocked reports whether (x, y) falls inside a wall tile. With no
+// Obstacles set, nothing is ever blocked.
+func (e *Environment) Blocked(x, y float64) bool {
+	if e.Obstacles == nil {
+		return false
+	}
+	return e.Obstacles.Blocked(x, y)
+}
+
+// RebuildIndex re-indexes agents for this tick's neighbour queries.
+func (e *Environment) RebuildIndex(agents []*Agent) {
+	qt := spatial.NewQuadtree(e.Bounds)
+	for _, a := range agents {
+		qt.Insert(spatial.Point{Pos: a.Pos, Data: a})
+	}
+	e.agentIndex = qt
+}
+
+// Neighbors returns every agent within radius of pos, using the index built
+// by the last RebuildIndex call.
+func (e *Environment) Neighbors(pos pixel.Vec, radius float64) []*Agent {
+	if e.agentIndex == nil {
+		return nil
+	}
+	window := pixel.R(pos.X-radius, pos.Y-radius, pos.X+radius, pos.Y+radius)
+	points := e.agentIndex.SearchArea(window)
+
+	agents := make([]*Agent, 0, len(points))
+	for _, p := range points {
+		if a, ok := p.Data.(*Agent); ok && pos.To(a.Pos).Len() <= radius {
+			agents = append(agents, a)
+		}
+	}
+	return agents
+}
+
+// KNN returns the k agents nearest to pos, closest first.
+func (e *Environment) KNN(pos pixel.Vec, k int) []*Agent {
+	if e.agentIndex == nil {
+		return nil
+	}
+	points := e.agentIndex.KNN(pos, k)
+	agents := make([]*Agent, 0, len(points))
+	for _, p := range points {
+		if a, ok := p.Data.(*Agent); ok {
+			agents = append(agents, a)
+		}
+	}
+	return agents
+}
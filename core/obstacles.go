@@ -0,0 +1,97 @@
+package core
+
+import (
+	"fmt"
+	"image/color"
+	"image/png"
+	"os"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+)
+
+// TileMap is a 2D grid of open (0) and wall (1) tiles covering an
+// environment, used to keep agents from tunnelling through walls.
+type TileMap struct {
+	tileSize   float64
+	cols, rows int
+	tiles      [][]int // tiles[row][col]; 0 = open, 1 = wall
+}
+
+// NewTileMap wraps a 2D tile grid (tiles[row][col], 0 = open, 1 = wall) at
+// the given tileSize. Rows may have differing lengths; Blocked treats any
+// cell past the end of a row as open.
+func NewTileMap(tiles [][]int, tileSize float64) *TileMap {
+	return &TileMap{tiles: tiles, tileSize: tileSize, rows: len(tiles)}
+}
+
+// LoadTileMapPNG builds a TileMap from a PNG where each pixel is one tile:
+// anything darker than the midpoint grey is a wall, everything else open.
+// The world is y-up but PNG rows run top-down, so row 0 of the TileMap
+// (y=0, the bottom of the world) is read from the bottom row of the image.
+func LoadTileMapPNG(path string, tileSize float64) (*TileMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("core: open tile map %q: %w", path, err)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("core: decode tile map %q: %w", path, err)
+	}
+
+	bounds := img.Bounds()
+	tiles := make([][]int, bounds.Dy())
+	for row := range tiles {
+		tiles[row] = make([]int, bounds.Dx())
+		srcY := bounds.Max.Y - 1 - row
+		for col := range tiles[row] {
+			tiles[row][col] = tileFromPixel(img.At(bounds.Min.X+col, srcY))
+		}
+	}
+	return NewTileMap(tiles, tileSize), nil
+}
+
+func tileFromPixel(c color.Color) int {
+	gray := color.GrayModel.Convert(c).(color.Gray)
+	if gray.Y < 128 {
+		return 1
+	}
+	return 0
+}
+
+// Blocked reports whether the tile containing world coordinates (x, y) is
+// a wall. Coordinates outside the map are treated as open, since callers
+// generally have their own bounds handling.
+func (t *TileMap) Blocked(x, y float64) bool {
+	if x < 0 || y < 0 {
+		return false
+	}
+	col := int(x / t.tileSize)
+	row := int(y / t.tileSize)
+	if row < 0 || row >= len(t.tiles) {
+		return false
+	}
+	if col < 0 || col >= len(t.tiles[row]) {
+		return false
+	}
+	return t.tiles[row][col] == 1
+}
+
+// Draw renders every wall tile as a filled rectangle tinted by color, so
+// an obstacle map is visible and not just felt through collision.
+func (t *TileMap) Draw(imd *imdraw.IMDraw, tint color.RGBA) {
+	imd.Color = tint
+	for row, cols := range t.tiles {
+		for col, v := range cols {
+			if v != 1 {
+				continue
+			}
+			x0 := float64(col) * t.tileSize
+			y0 := float64(row) * t.tileSize
+			imd.Push(pixel.V(x0, y0), pixel.V(x0+t.tileSize, y0+t.tileSize))
+			imd.Rectangle(0)
+		}
+	}
+}
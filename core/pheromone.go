@@ -0,0 +1,141 @@
+package core
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+)
+
+// PheromoneField holds one or more named scalar grids, each covering the
+// whole simulation window at a configurable cell resolution. Agents deposit
+// into a channel as they move and sense it ahead of themselves to steer,
+// the way ants follow trail pheromone or slime mould follows its own trace.
+type PheromoneField struct {
+	cellSize      float64
+	cols, rows    int
+	width, height float64
+	spreadRate    float64
+	decayRate     float64
+	grids         map[string][]float64
+	scratch       []float64 // reused during Step to avoid reallocating every tick
+}
+
+// NewPheromoneField creates a field sized to width x height, divided into
+// cellSize x cellSize cells, with one zeroed grid per named channel.
+// spreadRate and decayRate scale the diffusion and exponential decay
+// applied each Step.
+func NewPheromoneField(width, height, cellSize, spreadRate, decayRate float64, channels ...string) *PheromoneField {
+	cols := int(math.Ceil(width / cellSize))
+	rows := int(math.Ceil(height / cellSize))
+
+	f := &PheromoneField{
+		cellSize:   cellSize,
+		cols:       cols,
+		rows:       rows,
+		width:      width,
+		height:     height,
+		spreadRate: spreadRate,
+		decayRate:  decayRate,
+		grids:      make(map[string][]float64, len(channels)),
+		scratch:    make([]float64, cols*rows),
+	}
+	for _, ch := range channels {
+		f.grids[ch] = make([]float64, cols*rows)
+	}
+	return f
+}
+
+func (f *PheromoneField) cellAt(x, y float64) (int, int, bool) {
+	cx := int(x / f.cellSize)
+	cy := int(y / f.cellSize)
+	if cx < 0 || cy < 0 || cx >= f.cols || cy >= f.rows {
+		return 0, 0, false
+	}
+	return cx, cy, true
+}
+
+// Deposit adds amount to the channel's grid at the cell containing (x, y).
+func (f *PheromoneField) Deposit(x, y float64, channel string, amount float64) {
+	grid, ok := f.grids[channel]
+	if !ok {
+		return
+	}
+	cx, cy, ok := f.cellAt(x, y)
+	if !ok {
+		return
+	}
+	grid[cy*f.cols+cx] += amount
+}
+
+// Sense samples the channel's concentration at radius along angle from
+// (x, y), used by sensor points that look ahead of an agent.
+func (f *PheromoneField) Sense(x, y, angle, radius float64, channel string) float64 {
+	grid, ok := f.grids[channel]
+	if !ok {
+		return 0
+	}
+	sx := x + math.Cos(angle)*radius
+	sy := y + math.Sin(angle)*radius
+	cx, cy, ok := f.cellAt(sx, sy)
+	if !ok {
+		return 0
+	}
+	return grid[cy*f.cols+cx]
+}
+
+// Step advances every channel by dt: a 3x3 blur diffuses each cell into its
+// neighbours, then the result decays exponentially. This is run once per
+// frame regardless of dt size (dt only scales the decay and diffusion rate).
+func (f *PheromoneField) Step(dt float64) {
+	diffuseRate := f.spreadRate * dt
+	decay := math.Exp(-f.decayRate * dt)
+
+	for _, grid := range f.grids {
+		for cy := 0; cy < f.rows; cy++ {
+			for cx := 0; cx < f.cols; cx++ {
+				idx := cy*f.cols + cx
+				sum := 0.0
+				count := 0.0
+				for dy := -1; dy <= 1; dy++ {
+					for dx := -1; dx <= 1; dx++ {
+						nx, ny := cx+dx, cy+dy
+						if nx < 0 || ny < 0 || nx >= f.cols || ny >= f.rows {
+							continue
+						}
+						sum += grid[ny*f.cols+nx]
+						count++
+					}
+				}
+				blurred := sum / count
+				f.scratch[idx] = (grid[idx] + (blurred-grid[idx])*diffuseRate) * decay
+			}
+		}
+		copy(grid, f.scratch)
+	}
+}
+
+// Draw renders the channel as a translucent overlay, one quad per cell,
+// tinted by color. Concentration maps to alpha via alphaScale, capped at
+// maxAlpha.
+func (f *PheromoneField) Draw(imd *imdraw.IMDraw, channel string, tint color.RGBA, alphaScale, maxAlpha float64) {
+	grid, ok := f.grids[channel]
+	if !ok {
+		return
+	}
+	for cy := 0; cy < f.rows; cy++ {
+		for cx := 0; cx < f.cols; cx++ {
+			v := grid[cy*f.cols+cx]
+			if v <= 0.001 {
+				continue
+			}
+			alpha := math.Min(v*alphaScale, maxAlpha)
+			imd.Color = color.RGBA{R: tint.R, G: tint.G, B: tint.B, A: uint8(alpha)}
+			x0 := float64(cx) * f.cellSize
+			y0 := float64(cy) * f.cellSize
+			imd.Push(pixel.V(x0, y0), pixel.V(x0+f.cellSize, y0+f.cellSize))
+			imd.Rectangle(0)
+		}
+	}
+}
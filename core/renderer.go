@@ -0,0 +1,94 @@
+package core
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// RunConfig configures the shared windowed run loop in Run.
+type RunConfig struct {
+	Title      string
+	Bounds     pixel.Rect
+	Background color.RGBA
+	World      *World
+
+	// OnBeforeStep runs once per frame, right before World.Step, so a
+	// simulation can refresh state its actors read during Step (e.g.
+	// depositing and diffusing a pheromone field, or reindexing food).
+	OnBeforeStep func(dt float64)
+
+	// OnTick runs once per frame, right after World.Step, for a
+	// simulation's own bookkeeping (GA generation boundaries, spawning,
+	// debug HUDs, ...).
+	OnTick func(dt float64)
+
+	// OnDraw runs once per frame after the screen is cleared but before
+	// agents are drawn, so overlays (e.g. a pheromone field) render
+	// underneath them.
+	OnDraw func(imd *imdraw.IMDraw)
+}
+
+// Run opens a pixelgl window and drives cfg.World until it's closed,
+// stepping the world, calling the simulation's hooks, and drawing every
+// agent with its actor's Drawer if it has one, or a default translucent
+// circle otherwise. Every simulation in this repo shares this loop instead
+// of rolling its own. Like pixelgl itself, Run must be called from main()
+// on the main goroutine: it blocks until the window is closed.
+func Run(cfg RunConfig) {
+	pixelgl.Run(func() {
+		win, err := pixelgl.NewWindow(pixelgl.WindowConfig{
+			Title:  cfg.Title,
+			Bounds: cfg.Bounds,
+			VSync:  true,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		imd := imdraw.New(nil)
+		last := time.Now()
+
+		for !win.Closed() {
+			dt := time.Since(last).Seconds()
+			last = time.Now()
+
+			if cfg.OnBeforeStep != nil {
+				cfg.OnBeforeStep(dt)
+			}
+			cfg.World.Step(dt)
+			if cfg.OnTick != nil {
+				cfg.OnTick(dt)
+			}
+
+			win.Clear(cfg.Background)
+			imd.Clear()
+
+			if cfg.OnDraw != nil {
+				cfg.OnDraw(imd)
+			}
+			DrawAgents(imd, cfg.World)
+
+			imd.Draw(win)
+			win.Update()
+		}
+	})
+}
+
+// DrawAgents draws every agent in w: an actor implementing Drawer draws
+// itself, otherwise the agent gets the renderer's default translucent
+// circle.
+func DrawAgents(imd *imdraw.IMDraw, w *World) {
+	for i, a := range w.Agents {
+		if d, ok := w.Actor(i).(Drawer); ok {
+			d.Draw(imd, a)
+			continue
+		}
+		imd.Color = a.Color
+		imd.Push(a.Pos)
+		imd.Circle(a.Radius, 0)
+	}
+}
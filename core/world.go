@@ -0,0 +1,54 @@
+package core
+
+// World ties a set of agents to the actors driving them and the
+// environment they share. It is deliberately agnostic to what kind of
+// simulation it's running: composing multiple actor types (ants, slime,
+// boids, ...) in one World is just spawning agents with different Actors.
+type World struct {
+	Env    *Environment
+	Agents []*Agent
+	actors []Actor
+}
+
+// NewWorld creates an empty world over env.
+func NewWorld(env *Environment) *World {
+	return &World{Env: env}
+}
+
+// Spawn adds agent to the world under the given actor, immediately running
+// the actor's Setup.
+func (w *World) Spawn(agent *Agent, actor Actor) {
+	actor.Setup(w.Env, agent)
+	w.Agents = append(w.Agents, agent)
+	w.actors = append(w.actors, actor)
+}
+
+// Remove drops the agent at index i (and its actor) from the world.
+func (w *World) Remove(i int) {
+	w.Agents = append(w.Agents[:i], w.Agents[i+1:]...)
+	w.actors = append(w.actors[:i], w.actors[i+1:]...)
+}
+
+// Reset replaces the whole population at once, e.g. at a GA generation
+// boundary. Each agent/actor pair is assumed already set up (via Spawn or
+// equivalent) by the caller.
+func (w *World) Reset(agents []*Agent, actors []Actor) {
+	w.Agents = agents
+	w.actors = actors
+}
+
+// Step rebuilds the environment's spatial index over the current agents,
+// then steps every actor once.
+func (w *World) Step(dt float64) {
+	w.Env.RebuildIndex(w.Agents)
+	for i, actor := range w.actors {
+		actor.Step(w.Env, w.Agents[i], dt)
+	}
+}
+
+// Actor returns the actor driving the agent at index i, for callers that
+// need to type-assert it back to a concrete type (e.g. to draw it, or read
+// actor-specific stats).
+func (w *World) Actor(i int) Actor {
+	return w.actors[i]
+}
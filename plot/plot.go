@@ -0,0 +1,191 @@
+// Package plot renders small rolling line charts with imdraw, used for the
+// live generation-over-generation HUD overlaid on the simulation window.
+// There's no text-rendering dependency anywhere else in this repo, so the
+// legend is colour swatches rather than labelled text.
+package plot
+
+import (
+	"image/color"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+)
+
+// Series is a capped rolling history of one metric's value across
+// generations, tinted a fixed colour (typically one per species).
+type Series struct {
+	Label string
+	Color color.RGBA
+	cap   int
+	vals  []float64
+}
+
+// newSeries creates a series holding at most capacity points.
+func newSeries(label string, c color.RGBA, capacity int) *Series {
+	return &Series{Label: label, Color: c, cap: capacity}
+}
+
+// Push appends v, dropping the oldest point once the series is at capacity.
+func (s *Series) Push(v float64) {
+	s.vals = append(s.vals, v)
+	if len(s.vals) > s.cap {
+		s.vals = s.vals[len(s.vals)-s.cap:]
+	}
+}
+
+// Chart is one auto-scaled line chart over a fixed screen rect, drawing one
+// or more Series against a shared, per-frame-recomputed y-axis.
+type Chart struct {
+	Bounds pixel.Rect
+	Title  string
+
+	capacity int
+	series   []*Series
+}
+
+// NewChart creates an empty chart over bounds; each series it tracks holds
+// at most capacity points (oldest points are discarded to match).
+func NewChart(bounds pixel.Rect, title string, capacity int) *Chart {
+	return &Chart{Bounds: bounds, Title: title, capacity: capacity}
+}
+
+// Track returns the named series, creating it with colour c on first use.
+func (c *Chart) Track(label string, col color.RGBA) *Series {
+	for _, s := range c.series {
+		if s.Label == label {
+			return s
+		}
+	}
+	s := newSeries(label, col, c.capacity)
+	c.series = append(c.series, s)
+	return s
+}
+
+const gridLines = 4
+
+// Draw renders the chart's axes, gridLines-1 horizontal gridlines (splitting
+// its height into gridLines bands), every
+// tracked series as a polyline auto-scaled to the chart's current min/max,
+// and a legend of colour swatches in its top-right corner.
+func (c *Chart) Draw(imd *imdraw.IMDraw) {
+	b := c.Bounds
+
+	imd.Color = color.RGBA{R: 255, G: 255, B: 255, A: 60}
+	imd.Push(b.Min, pixel.V(b.Min.X, b.Max.Y))
+	imd.Line(1)
+	imd.Push(b.Min, pixel.V(b.Max.X, b.Min.Y))
+	imd.Line(1)
+
+	for i := 1; i < gridLines; i++ {
+		y := b.Min.Y + b.H()*float64(i)/float64(gridLines)
+		imd.Color = color.RGBA{R: 255, G: 255, B: 255, A: 25}
+		imd.Push(pixel.V(b.Min.X, y), pixel.V(b.Max.X, y))
+		imd.Line(0.5)
+	}
+
+	min, max := c.valueRange()
+	for _, s := range c.series {
+		c.drawSeries(imd, s, min, max)
+	}
+	c.drawLegend(imd)
+}
+
+// valueRange returns the min/max value across every tracked series, so all
+// of a chart's series share one y-axis scale. With no points yet recorded,
+// it returns (0, 1) so the chart still draws sensible gridlines.
+func (c *Chart) valueRange() (min, max float64) {
+	min, max = 0, 1
+	first := true
+	for _, s := range c.series {
+		for _, v := range s.vals {
+			if first {
+				min, max, first = v, v, false
+			}
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	if min == max {
+		max = min + 1
+	}
+	return min, max
+}
+
+func (c *Chart) drawSeries(imd *imdraw.IMDraw, s *Series, min, max float64) {
+	if len(s.vals) < 2 {
+		return
+	}
+	b := c.Bounds
+	imd.Color = s.Color
+	for i, v := range s.vals {
+		x := b.Min.X + b.W()*float64(i)/float64(c.capacity-1)
+		y := b.Min.Y + b.H()*(v-min)/(max-min)
+		imd.Push(pixel.V(x, y))
+	}
+	imd.Line(1.5)
+}
+
+const swatchSize = 6
+
+// drawLegend draws one small filled square per series, stacked down the
+// chart's top-right corner in track order.
+func (c *Chart) drawLegend(imd *imdraw.IMDraw) {
+	b := c.Bounds
+	for i, s := range c.series {
+		top := b.Max.Y - float64(i)*(swatchSize+2)
+		imd.Color = s.Color
+		imd.Push(pixel.V(b.Max.X-swatchSize, top-swatchSize), pixel.V(b.Max.X, top))
+		imd.Rectangle(0)
+	}
+}
+
+// HUD lays out a fixed set of Charts stacked in a column and redraws them
+// once per frame from whatever values Record has accumulated.
+type HUD struct {
+	charts []*Chart
+}
+
+// NewHUD creates a HUD with one chart per title (in order), stacked from
+// the top of region downward, each holding at most capacity points per
+// series.
+func NewHUD(region pixel.Rect, titles []string, capacity int) *HUD {
+	h := &HUD{}
+	chartHeight := region.H() / float64(len(titles))
+	for i, title := range titles {
+		top := region.Max.Y - float64(i)*chartHeight
+		bounds := pixel.R(region.Min.X, top-chartHeight+4, region.Max.X, top-4)
+		h.charts = append(h.charts, NewChart(bounds, title, capacity))
+	}
+	return h
+}
+
+// Chart looks up a chart by title, or nil if none matches.
+func (h *HUD) Chart(title string) *Chart {
+	for _, c := range h.charts {
+		if c.Title == title {
+			return c
+		}
+	}
+	return nil
+}
+
+// Record pushes value onto the named series of the named chart. It's a
+// no-op if title doesn't match a chart NewHUD created.
+func (h *HUD) Record(title, seriesLabel string, col color.RGBA, value float64) {
+	chart := h.Chart(title)
+	if chart == nil {
+		return
+	}
+	chart.Track(seriesLabel, col).Push(value)
+}
+
+// Draw renders every chart in the HUD.
+func (h *HUD) Draw(imd *imdraw.IMDraw) {
+	for _, c := range h.charts {
+		c.Draw(imd)
+	}
+}
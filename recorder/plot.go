@@ -0,0 +1,91 @@
+package recorder
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+)
+
+const (
+	plotWidth  = 400
+	plotHeight = 400
+	plotMargin = 10
+)
+
+// plotScatter dumps a population scatter PNG for one generation: each axol
+// is a small square plotted at its (x, y) position, scaled to the
+// population's bounding box, and tinted its own genome colour, giving a
+// quick visual read on clustering without needing to watch the live window.
+// Consumed food is not shown.
+func plotScatter(path string, snaps []AxolSnapshot) error {
+	img := image.NewRGBA(image.Rect(0, 0, plotWidth, plotHeight))
+	background := color.RGBA{R: 20, G: 0, B: 30, A: 255}
+	for y := 0; y < plotHeight; y++ {
+		for x := 0; x < plotWidth; x++ {
+			img.Set(x, y, background)
+		}
+	}
+
+	minX, maxX, minY, maxY := boundsOf(snaps)
+	for _, s := range snaps {
+		px := scale(s.PosX, minX, maxX, plotMargin, plotWidth-plotMargin)
+		py := scale(s.PosY, minY, maxY, plotMargin, plotHeight-plotMargin)
+		drawDot(img, px, py, color.RGBA{R: s.R, G: s.G, B: s.B, A: 255})
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return png.Encode(f, img)
+}
+
+func boundsOf(snaps []AxolSnapshot) (minX, maxX, minY, maxY float64) {
+	if len(snaps) == 0 {
+		return 0, 1, 0, 1
+	}
+	minX, maxX = snaps[0].PosX, snaps[0].PosX
+	minY, maxY = snaps[0].PosY, snaps[0].PosY
+	for _, s := range snaps {
+		if s.PosX < minX {
+			minX = s.PosX
+		}
+		if s.PosX > maxX {
+			maxX = s.PosX
+		}
+		if s.PosY < minY {
+			minY = s.PosY
+		}
+		if s.PosY > maxY {
+			maxY = s.PosY
+		}
+	}
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	if maxY == minY {
+		maxY = minY + 1
+	}
+	return minX, maxX, minY, maxY
+}
+
+func scale(v, min, max float64, outMin, outMax int) int {
+	t := (v - min) / (max - min)
+	return outMin + int(t*float64(outMax-outMin))
+}
+
+func drawDot(img *image.RGBA, cx, cy int, c color.RGBA) {
+	const r = 2
+	for dy := -r; dy <= r; dy++ {
+		for dx := -r; dx <= r; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || y < 0 || x >= plotWidth || y >= plotHeight {
+				continue
+			}
+			img.Set(x, y, c)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package recorder writes per-generation evolution data to disk so GA runs
+// can be replayed, diffed, and regression-tested instead of only eyeballed
+// in the live window.
+package recorder
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AxolSnapshot is a recorder-friendly copy of one axol's state at the end
+// of a generation. It deliberately doesn't import the main package's AxolActor
+// type, so the simulation stays free to call into recorder without a cycle.
+type AxolSnapshot struct {
+	Species      int
+	Size         float64
+	Speed        float64
+	SenseRadius  float64
+	R, G, B      uint8
+	ConsumedFood int
+	PosX, PosY   float64
+}
+
+// Recorder owns the per-axol and per-generation CSV files for one run.
+type Recorder struct {
+	dir string
+
+	axolFile   *os.File
+	axolWriter *csv.Writer
+
+	statsFile   *os.File
+	statsWriter *csv.Writer
+
+	plot bool
+}
+
+// New creates dir (if needed) and opens axols.csv and stats.csv inside it,
+// writing their headers. When plot is true, RecordGeneration also dumps a
+// population scatter PNG per generation.
+func New(dir string, plot bool) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("recorder: create dir: %w", err)
+	}
+
+	axolFile, err := os.Create(filepath.Join(dir, "axols.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("recorder: create axols.csv: %w", err)
+	}
+	axolWriter := csv.NewWriter(axolFile)
+	if err := axolWriter.Write([]string{
+		"generation", "species", "size", "speed", "senseRadius", "r", "g", "b", "consumedFood",
+	}); err != nil {
+		return nil, fmt.Errorf("recorder: write axols.csv header: %w", err)
+	}
+
+	statsFile, err := os.Create(filepath.Join(dir, "stats.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("recorder: create stats.csv: %w", err)
+	}
+	statsWriter := csv.NewWriter(statsFile)
+	if err := statsWriter.Write([]string{
+		"generation", "species",
+		"meanConsumed", "maxConsumed", "minConsumed",
+		"meanSize", "varSize", "meanSpeed", "varSpeed", "meanSenseRadius", "varSenseRadius",
+	}); err != nil {
+		return nil, fmt.Errorf("recorder: write stats.csv header: %w", err)
+	}
+
+	return &Recorder{
+		dir:         dir,
+		axolFile:    axolFile,
+		axolWriter:  axolWriter,
+		statsFile:   statsFile,
+		statsWriter: statsWriter,
+		plot:        plot,
+	}, nil
+}
+
+// RecordGeneration appends one row per axol to axols.csv, one aggregate row
+// per species to stats.csv, and, if plotting is enabled, a population
+// scatter PNG for the generation.
+func (r *Recorder) RecordGeneration(generation int, snaps []AxolSnapshot) error {
+	for _, s := range snaps {
+		row := []string{
+			fmt.Sprint(generation),
+			fmt.Sprint(s.Species),
+			fmt.Sprintf("%f", s.Size),
+			fmt.Sprintf("%f", s.Speed),
+			fmt.Sprintf("%f", s.SenseRadius),
+			fmt.Sprint(s.R), fmt.Sprint(s.G), fmt.Sprint(s.B),
+			fmt.Sprint(s.ConsumedFood),
+		}
+		if err := r.axolWriter.Write(row); err != nil {
+			return fmt.Errorf("recorder: write axols.csv row: %w", err)
+		}
+	}
+	r.axolWriter.Flush()
+	if err := r.axolWriter.Error(); err != nil {
+		return fmt.Errorf("recorder: flush axols.csv: %w", err)
+	}
+
+	for species, stats := range aggregateBySpecies(snaps) {
+		row := []string{
+			fmt.Sprint(generation),
+			fmt.Sprint(species),
+			fmt.Sprintf("%f", stats.meanConsumed),
+			fmt.Sprintf("%f", stats.maxConsumed),
+			fmt.Sprintf("%f", stats.minConsumed),
+			fmt.Sprintf("%f", stats.meanSize), fmt.Sprintf("%f", stats.varSize),
+			fmt.Sprintf("%f", stats.meanSpeed), fmt.Sprintf("%f", stats.varSpeed),
+			fmt.Sprintf("%f", stats.meanSenseRadius), fmt.Sprintf("%f", stats.varSenseRadius),
+		}
+		if err := r.statsWriter.Write(row); err != nil {
+			return fmt.Errorf("recorder: write stats.csv row: %w", err)
+		}
+	}
+	r.statsWriter.Flush()
+	if err := r.statsWriter.Error(); err != nil {
+		return fmt.Errorf("recorder: flush stats.csv: %w", err)
+	}
+
+	if r.plot {
+		path := filepath.Join(r.dir, fmt.Sprintf("generation_%04d.png", generation))
+		if err := plotScatter(path, snaps); err != nil {
+			return fmt.Errorf("recorder: plot generation %d: %w", generation, err)
+		}
+	}
+
+	return nil
+}
+
+// Close flushes and closes both CSV files.
+func (r *Recorder) Close() error {
+	r.axolWriter.Flush()
+	r.statsWriter.Flush()
+	if err := r.axolFile.Close(); err != nil {
+		return err
+	}
+	return r.statsFile.Close()
+}
+
+type speciesStats struct {
+	meanConsumed, maxConsumed, minConsumed float64
+	meanSize, varSize                      float64
+	meanSpeed, varSpeed                    float64
+	meanSenseRadius, varSenseRadius        float64
+}
+
+func aggregateBySpecies(snaps []AxolSnapshot) map[int]speciesStats {
+	grouped := make(map[int][]AxolSnapshot)
+	for _, s := range snaps {
+		grouped[s.Species] = append(grouped[s.Species], s)
+	}
+
+	out := make(map[int]speciesStats, len(grouped))
+	for species, group := range grouped {
+		n := float64(len(group))
+		var sumConsumed, maxConsumed, minConsumed float64
+		var sumSize, sumSpeed, sumSense float64
+		minConsumed = float64(group[0].ConsumedFood)
+
+		for _, s := range group {
+			consumed := float64(s.ConsumedFood)
+			sumConsumed += consumed
+			if consumed > maxConsumed {
+				maxConsumed = consumed
+			}
+			if consumed < minConsumed {
+				minConsumed = consumed
+			}
+			sumSize += s.Size
+			sumSpeed += s.Speed
+			sumSense += s.SenseRadius
+		}
+
+		meanSize := sumSize / n
+		meanSpeed := sumSpeed / n
+		meanSense := sumSense / n
+
+		var varSize, varSpeed, varSense float64
+		for _, s := range group {
+			varSize += (s.Size - meanSize) * (s.Size - meanSize)
+			varSpeed += (s.Speed - meanSpeed) * (s.Speed - meanSpeed)
+			varSense += (s.SenseRadius - meanSense) * (s.SenseRadius - meanSense)
+		}
+		varSize /= n
+		varSpeed /= n
+		varSense /= n
+
+		out[species] = speciesStats{
+			meanConsumed:    sumConsumed / n,
+			maxConsumed:     maxConsumed,
+			minConsumed:     minConsumed,
+			meanSize:        meanSize,
+			varSize:         varSize,
+			meanSpeed:       meanSpeed,
+			varSpeed:        varSpeed,
+			meanSenseRadius: meanSense,
+			varSenseRadius:  varSense,
+		}
+	}
+	return out
+}
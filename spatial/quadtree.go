@@ -0,0 +1,155 @@
+// Package spatial provides a quadtree spatial index over pixel.Vec
+// positions, used to replace O(n^2) nearest-neighbour scans with
+// logarithmic-ish area and k-nearest queries.
+package spatial
+
+import (
+	"sort"
+
+	"github.com/faiface/pixel"
+)
+
+// capacity is how many points a node holds before it subdivides.
+const capacity = 4
+
+// Point pairs a position with arbitrary caller data (e.g. *core.Agent or *Food),
+// so the tree can be used for any entity that has a pixel.Vec position.
+type Point struct {
+	Pos  pixel.Vec
+	Data interface{}
+}
+
+// Quadtree is a region quadtree over pixel.Vec points. The zero value is
+// not usable; construct one with NewQuadtree.
+type Quadtree struct {
+	bounds   pixel.Rect
+	points   []Point
+	divided  bool
+	children [4]*Quadtree
+}
+
+// NewQuadtree creates an empty quadtree covering bounds.
+func NewQuadtree(bounds pixel.Rect) *Quadtree {
+	return &Quadtree{bounds: bounds}
+}
+
+// Insert adds p to the tree, subdividing as needed. It reports false if p
+// falls outside the tree's bounds.
+func (q *Quadtree) Insert(p Point) bool {
+	if !q.bounds.Contains(p.Pos) {
+		return false
+	}
+
+	if !q.divided && len(q.points) < capacity {
+		q.points = append(q.points, p)
+		return true
+	}
+
+	if !q.divided {
+		q.subdivide()
+	}
+
+	for _, child := range q.children {
+		if child.Insert(p) {
+			return true
+		}
+	}
+	// Shouldn't happen if bounds are disjoint and cover the parent, but
+	// fall back to storing it here rather than dropping the point.
+	q.points = append(q.points, p)
+	return true
+}
+
+func (q *Quadtree) subdivide() {
+	min, max := q.bounds.Min, q.bounds.Max
+	mid := q.bounds.Center()
+
+	q.children[0] = NewQuadtree(pixel.R(min.X, min.Y, mid.X, mid.Y))
+	q.children[1] = NewQuadtree(pixel.R(mid.X, min.Y, max.X, mid.Y))
+	q.children[2] = NewQuadtree(pixel.R(min.X, mid.Y, mid.X, max.Y))
+	q.children[3] = NewQuadtree(pixel.R(mid.X, mid.Y, max.X, max.Y))
+	q.divided = true
+
+	existing := q.points
+	q.points = nil
+	for _, p := range existing {
+		for _, child := range q.children {
+			if child.Insert(p) {
+				break
+			}
+		}
+	}
+}
+
+// SearchArea returns every point whose position falls within bounds.
+func (q *Quadtree) SearchArea(bounds pixel.Rect) []Point {
+	var found []Point
+	q.searchArea(bounds, &found)
+	return found
+}
+
+func (q *Quadtree) searchArea(bounds pixel.Rect, found *[]Point) {
+	if !overlaps(q.bounds, bounds) {
+		return
+	}
+	for _, p := range q.points {
+		if bounds.Contains(p.Pos) {
+			*found = append(*found, p)
+		}
+	}
+	if q.divided {
+		for _, child := range q.children {
+			child.searchArea(bounds, found)
+		}
+	}
+}
+
+// KNN returns the k points nearest to p, closest first. It works by
+// expanding a square search window around p until it holds at least k
+// candidates (or the whole tree), then sorting those by distance. If the
+// k-th candidate lies farther than the window's half-width, a nearer point
+// may sit just outside the window, so the search is repeated once with a
+// window wide enough to hold every point within that distance.
+func (q *Quadtree) KNN(p pixel.Vec, k int) []Point {
+	if k <= 0 {
+		return nil
+	}
+
+	span := q.bounds.Max.Sub(q.bounds.Min)
+	radius := (span.X + span.Y) / 20 // a reasonable starting window
+	if radius <= 0 {
+		radius = 1
+	}
+
+	var candidates []Point
+	for {
+		window := pixel.R(p.X-radius, p.Y-radius, p.X+radius, p.Y+radius).Norm()
+		candidates = q.SearchArea(window)
+		if len(candidates) >= k || window.Contains(q.bounds.Min) && window.Contains(q.bounds.Max) {
+			break
+		}
+		radius *= 2
+	}
+
+	byDistance := func() {
+		sort.Slice(candidates, func(i, j int) bool {
+			return p.To(candidates[i].Pos).Len() < p.To(candidates[j].Pos).Len()
+		})
+	}
+	byDistance()
+	if len(candidates) >= k {
+		if kth := p.To(candidates[k-1].Pos).Len(); kth > radius {
+			candidates = q.SearchArea(pixel.R(p.X-kth, p.Y-kth, p.X+kth, p.Y+kth))
+			byDistance()
+		}
+	}
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+	return candidates
+}
+
+func overlaps(a, b pixel.Rect) bool {
+	return a.Min.X <= b.Max.X && a.Max.X >= b.Min.X &&
+		a.Min.Y <= b.Max.Y && a.Max.Y >= b.Min.Y
+}